@@ -0,0 +1,581 @@
+// SPDX-FileCopyrightText: © 2025 StreamKit Contributors
+//
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build tinygo.wasm
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+
+	"github.com/streamkit/streamkit-codex/plugin-sdk/go/bindings/streamkit/plugin/host"
+	"github.com/streamkit/streamkit-codex/plugin-sdk/go/bindings/streamkit/plugin/node"
+	"github.com/streamkit/streamkit-codex/plugin-sdk/go/bindings/streamkit/plugin/types"
+	"github.com/streamkit/streamkit-codex/plugin-sdk/go/sdk/runtime"
+	"go.bytecodealliance.org/cm"
+)
+
+const gainKind = "gain_filter_go"
+
+const (
+	defaultSampleRate = 48_000
+	defaultChannels   = 2
+
+	defaultGainDB = float32(0)
+	minGainDB     = float32(-60)
+	maxGainDB     = float32(20)
+
+	defaultRampMs = float32(20)
+	maxRampMs     = float32(5_000)
+
+	defaultMeterIntervalMs = float32(50)
+	minMeterIntervalMs     = float32(1)
+	maxMeterIntervalMs     = float32(60_000)
+
+	meterWindowSeconds = 3.0
+
+	// automationParamGainDB names gain_filter_go's one automatable target in
+	// both the runtime.ParamAutomation schedule and incoming ControlEvent.Param.
+	automationParamGainDB = "gain_db"
+)
+
+var (
+	supportedSampleRates = []uint32{44_100, 48_000, 96_000}
+	supportedChannels    = []uint32{1, 2}
+	supportedFormats     = []types.SampleFormat{types.SampleFormatInt16, types.SampleFormatFloat32}
+
+	defaultAudioFormat = types.AudioFormat{
+		SampleRate:   defaultSampleRate,
+		Channels:     defaultChannels,
+		SampleFormat: types.SampleFormatFloat32,
+	}
+)
+
+// supportedAudioFormats returns every combination of sample rate, channel
+// count, and sample format the gain filter is willing to negotiate.
+func supportedAudioFormats() []types.AudioFormat {
+	formats := make([]types.AudioFormat, 0, len(supportedSampleRates)*len(supportedChannels)*len(supportedFormats))
+	for _, rate := range supportedSampleRates {
+		for _, channels := range supportedChannels {
+			for _, format := range supportedFormats {
+				formats = append(formats, types.AudioFormat{
+					SampleRate:   rate,
+					Channels:     channels,
+					SampleFormat: format,
+				})
+			}
+		}
+	}
+	return formats
+}
+
+func init() {
+	nodes.RegisterNode(gainKind, runtime.NodeDescriptor{
+		Metadata:     gainMetadata,
+		Constructor:  constructGainInstance,
+		Process:      processGainPacket,
+		UpdateParams: updateGainParams,
+		Cleanup:      cleanupGainInstance,
+		SaveState:    saveGainState,
+		LoadState:    loadGainState,
+	})
+}
+
+type gainParams struct {
+	GainDB          *float32 `json:"gain_db"`
+	RampMs          *float32 `json:"ramp_ms"`
+	RampShape       *string  `json:"ramp_shape"`
+	MeterIntervalMs *float32 `json:"meter_interval_ms"`
+}
+
+type gainInstance struct {
+	mu         sync.Mutex
+	sampleRate uint32
+	gainDB     float32
+	ramp       *runtime.ParameterRamp
+
+	// automation holds gain_db events received on the "ctrl" pin, scheduled
+	// by absolute sample position and applied as audio packets arrive on
+	// "in".
+	automation *runtime.ParamAutomation
+
+	// format is the AudioFormat negotiated on the first packet this instance
+	// sees. It is cached here so later packets don't repeat the match.
+	format *types.AudioFormat
+
+	// meters holds one ITU-R BS.1770 K-weighted meter per channel of format,
+	// reallocated whenever format (re)negotiates.
+	meters          []*runtime.ChannelMeter
+	meterIntervalMs float32
+	meterFramesDue  int
+	sampleClock     uint64
+}
+
+func gainMetadata() types.NodeMetadata {
+	packetTypes := make([]types.PacketType, 0, len(supportedSampleRates)*len(supportedChannels)*len(supportedFormats))
+	for _, format := range supportedAudioFormats() {
+		packetTypes = append(packetTypes, types.PacketTypeRawAudio(format))
+	}
+
+	inputs := []types.InputPin{
+		{
+			Name:         "in",
+			AcceptsTypes: cm.ToList(packetTypes),
+		},
+		{
+			Name:         "ctrl",
+			AcceptsTypes: cm.ToList([]types.PacketType{types.PacketTypeControlEvents()}),
+		},
+	}
+
+	outputs := []types.OutputPin{
+		{
+			Name:         "out",
+			ProducesType: types.PacketTypeRawAudio(defaultAudioFormat),
+		},
+		{
+			Name:         "meter",
+			ProducesType: types.PacketTypeMetering(),
+		},
+	}
+
+	return types.NodeMetadata{
+		Kind:        gainKind,
+		Inputs:      cm.ToList(inputs),
+		Outputs:     cm.ToList(outputs),
+		ParamSchema: gainSchema(),
+		Categories:  cm.ToList([]string{"audio", "filters"}),
+	}
+}
+
+func constructGainInstance(params cm.Option[string]) node.NodeInstance {
+	inst := &gainInstance{
+		sampleRate:      defaultSampleRate,
+		ramp:            runtime.NewParameterRamp(1),
+		automation:      runtime.NewParamAutomation(),
+		meters:          newChannelMeters(defaultChannels, defaultSampleRate),
+		meterIntervalMs: defaultMeterIntervalMs,
+	}
+	inst.meterFramesDue = meterIntervalSamples(inst.sampleRate, inst.meterIntervalMs)
+	if err := inst.applyParams(optionToPtr(params)); err != nil {
+		host.Log(host.LogLevelError, "gain_filter: failed to parse params: "+err.Error())
+	}
+	return nodes.Handles().Insert(gainKind, inst)
+}
+
+func processGainPacket(rep cm.Rep, inputPin string, packet types.Packet) runtime.Result {
+	inst, ok := runtime.GetTyped[gainInstance](nodes.Handles(), rep)
+	if !ok {
+		return runtime.Err("gain_filter: unknown instance handle")
+	}
+
+	switch inputPin {
+	case "in":
+		return inst.processAudio(packet)
+	case "ctrl":
+		return inst.processControlEvents(packet)
+	default:
+		return runtime.Err("gain_filter: unexpected input pin")
+	}
+}
+
+func (i *gainInstance) processAudio(packet types.Packet) runtime.Result {
+	audio := packet.Audio()
+	if audio == nil {
+		return runtime.Err("gain_filter: \"in\" only accepts audio packets")
+	}
+
+	i.mu.Lock()
+	if i.format == nil || *i.format != audio.Format {
+		format, ok := runtime.NegotiateAudioFormat(supportedAudioFormats(), audio.Format)
+		if !ok {
+			i.mu.Unlock()
+			return runtime.Err("gain_filter: unsupported audio format")
+		}
+		i.format = &format
+		i.sampleRate = format.SampleRate
+		i.meters = newChannelMeters(format.Channels, format.SampleRate)
+		i.meterFramesDue = meterIntervalSamples(format.SampleRate, i.meterIntervalMs)
+		i.sampleClock = 0
+		// Pending ctrl events were scheduled against the old sample clock;
+		// carrying them over would fire them at a meaningless time (or
+		// strand them forever) against the reset clock.
+		i.automation = runtime.NewParamAutomation()
+	}
+
+	samples := audio.Samples.Slice()
+	channels := int(i.format.Channels)
+	frames := 0
+	if channels > 0 {
+		frames = len(samples) / channels
+	}
+	i.applyGain(samples, channels, frames)
+
+	meterings := i.meterSamples(samples)
+	i.mu.Unlock()
+
+	audio.Samples = cm.ToList(samples)
+
+	if sendResult := host.SendOutput("out", types.PacketAudio(*audio)); sendResult.IsErr() {
+		errVal := sendResult.Err()
+		if errVal != nil {
+			return runtime.Err(*errVal)
+		}
+		return runtime.Err("gain_filter: host send failed")
+	}
+
+	for _, metering := range meterings {
+		if sendResult := host.SendOutput("meter", types.PacketMetering(metering)); sendResult.IsErr() {
+			host.Log(host.LogLevelError, "gain_filter: failed to send metering output")
+		}
+	}
+
+	return runtime.OK()
+}
+
+// processControlEvents schedules a ctrl packet's events onto i.automation,
+// at the absolute sample position each event's OffsetSamples names relative
+// to i's sample clock at the moment the packet is processed.
+func (i *gainInstance) processControlEvents(packet types.Packet) runtime.Result {
+	events := packet.ControlEvents()
+	if events == nil {
+		return runtime.Err("gain_filter: \"ctrl\" only accepts control event packets")
+	}
+
+	i.mu.Lock()
+	base := i.sampleClock
+	for _, ev := range events.Events.Slice() {
+		if ev.Param != automationParamGainDB {
+			continue
+		}
+		i.automation.Enqueue(runtime.AutomationEvent{
+			At:    base + uint64(ev.OffsetSamples),
+			Param: automationParamGainDB,
+			Value: clamp(ev.Value, minGainDB, maxGainDB),
+			Curve: runtime.ParseAutomationCurve(ev.Curve),
+		})
+	}
+	i.mu.Unlock()
+
+	return runtime.OK()
+}
+
+// applyGain walks any gain_db automation events due within this packet's
+// frame range and applies each at its exact sample offset, interpolating
+// from whatever preceded it according to the event's curve, then runs the
+// ramp over the remainder of the packet. It must be called with i.mu held.
+func (i *gainInstance) applyGain(samples []float32, channels, frames int) {
+	packetStart := i.sampleClock
+	due := i.automation.Due(automationParamGainDB, packetStart+uint64(frames))
+
+	offset := 0
+	for _, ev := range due {
+		evFrame := 0
+		if ev.At > packetStart {
+			evFrame = int(ev.At - packetStart)
+		}
+		if evFrame > frames {
+			evFrame = frames
+		}
+
+		if ev.Curve != runtime.CurveStep {
+			// durationMs is 0 when evFrame == offset (the event fires at the
+			// very start of this packet, or coincides with the previous
+			// one): SetTarget with a zero ramp applies it instantly, which
+			// is correct here since there's no span left to ramp across.
+			durationMs := float32(evFrame-offset) * 1000 / float32(i.sampleRate)
+			i.ramp.SetTarget(dbToLinear(ev.Value), durationMs, i.sampleRate, ev.Curve.RampShape())
+		}
+		if evFrame > offset {
+			i.applyGainRange(samples, channels, offset, evFrame)
+			offset = evFrame
+		}
+		if ev.Curve == runtime.CurveStep {
+			i.ramp.SetTarget(dbToLinear(ev.Value), 0, i.sampleRate, runtime.RampLinear)
+		}
+		i.gainDB = ev.Value
+	}
+
+	i.applyGainRange(samples, channels, offset, frames)
+}
+
+// applyGainRange runs i.ramp, in the sample format negotiated for this
+// instance, over samples[startFrame:endFrame) (frames, not raw elements).
+func (i *gainInstance) applyGainRange(samples []float32, channels, startFrame, endFrame int) {
+	lo, hi := startFrame*channels, endFrame*channels
+	if lo >= hi {
+		return
+	}
+
+	switch i.format.SampleFormat {
+	case types.SampleFormatInt16:
+		runtime.ApplyGainInt16(samples[lo:hi], i.ramp)
+	default:
+		runtime.ApplyGainFloat32(samples[lo:hi], i.ramp)
+	}
+}
+
+// meterSamples folds post-gain samples into the per-channel meters, walking
+// interval boundaries within the packet so a packet spanning more than one
+// meterIntervalMs worth of frames emits one Metering snapshot per elapsed
+// interval instead of folding them all into a single reading. It must be
+// called with i.mu held.
+func (i *gainInstance) meterSamples(samples []float32) []types.Metering {
+	channels := len(i.meters)
+	if channels == 0 || len(samples)%channels != 0 {
+		return nil
+	}
+
+	frames := len(samples) / channels
+	var readings []types.Metering
+
+	for offset := 0; offset < frames; {
+		step := frames - offset
+		if step > i.meterFramesDue {
+			step = i.meterFramesDue
+		}
+
+		for idx, sample := range samples[offset*channels : (offset+step)*channels] {
+			i.meters[idx%channels].Add(sample)
+		}
+		offset += step
+
+		i.sampleClock += uint64(step)
+		i.meterFramesDue -= step
+		if i.meterFramesDue > 0 {
+			continue
+		}
+		i.meterFramesDue += meterIntervalSamples(i.sampleRate, i.meterIntervalMs)
+
+		readings = append(readings, i.snapshotMeters())
+	}
+
+	return readings
+}
+
+// snapshotMeters builds a Metering reading from the current per-channel
+// meter state and resets the interval counters. It must be called with i.mu
+// held.
+func (i *gainInstance) snapshotMeters() types.Metering {
+	channelMetrics := make([]types.ChannelMetering, len(i.meters))
+	for idx, meter := range i.meters {
+		channelMetrics[idx] = types.ChannelMetering{
+			PeakDB:         meter.PeakDB(),
+			RMSDB:          meter.RMSDB(),
+			LUFSShortTerm:  meter.LUFSShortTerm(),
+			ClippedSamples: meter.ClippedSamples(),
+		}
+		meter.Reset()
+	}
+
+	timestampMs := uint64(0)
+	if i.sampleRate > 0 {
+		timestampMs = i.sampleClock * 1000 / uint64(i.sampleRate)
+	}
+
+	return types.Metering{
+		TimestampMs: timestampMs,
+		Channels:    cm.ToList(channelMetrics),
+	}
+}
+
+// newChannelMeters allocates one K-weighted meter per channel.
+func newChannelMeters(channels, sampleRate uint32) []*runtime.ChannelMeter {
+	meters := make([]*runtime.ChannelMeter, channels)
+	for i := range meters {
+		meters[i] = runtime.NewChannelMeter(sampleRate, meterWindowSeconds)
+	}
+	return meters
+}
+
+// meterIntervalSamples converts a reporting interval to a frame count at
+// sampleRate, never less than one frame.
+func meterIntervalSamples(sampleRate uint32, intervalMs float32) int {
+	frames := int(float32(sampleRate) * intervalMs / 1000)
+	if frames < 1 {
+		frames = 1
+	}
+	return frames
+}
+
+func updateGainParams(rep cm.Rep, params cm.Option[string]) runtime.Result {
+	inst, ok := runtime.GetTyped[gainInstance](nodes.Handles(), rep)
+	if !ok {
+		return runtime.Err("gain_filter: unknown instance handle")
+	}
+
+	if err := inst.applyParams(optionToPtr(params)); err != nil {
+		return runtime.Err(err.Error())
+	}
+
+	return runtime.OK()
+}
+
+func cleanupGainInstance(rep cm.Rep) {
+	if inst, ok := runtime.GetTyped[gainInstance](nodes.Handles(), rep); ok {
+		host.Log(host.LogLevelInfo, "gain_filter instance shutting down")
+		inst.mu.Lock()
+		inst.ramp.SetTarget(1, 0, inst.sampleRate, runtime.RampLinear)
+		inst.mu.Unlock()
+	}
+}
+
+func (i *gainInstance) applyParams(params *string) error {
+	gainDB := defaultGainDB
+	rampMs := defaultRampMs
+	rampShape := runtime.RampLinear
+	meterIntervalMs := defaultMeterIntervalMs
+
+	if params != nil {
+		var decoded gainParams
+		if err := json.Unmarshal([]byte(*params), &decoded); err != nil {
+			return err
+		}
+		if decoded.GainDB != nil {
+			gainDB = clamp(*decoded.GainDB, minGainDB, maxGainDB)
+		}
+		if decoded.RampMs != nil {
+			rampMs = clamp(*decoded.RampMs, 0, maxRampMs)
+		}
+		if decoded.RampShape != nil && *decoded.RampShape == "exp" {
+			rampShape = runtime.RampExponential
+		}
+		if decoded.MeterIntervalMs != nil {
+			meterIntervalMs = clamp(*decoded.MeterIntervalMs, minMeterIntervalMs, maxMeterIntervalMs)
+		}
+	}
+
+	gainLinear := dbToLinear(gainDB)
+
+	i.mu.Lock()
+	i.gainDB = gainDB
+	i.ramp.SetTarget(gainLinear, rampMs, i.sampleRate, rampShape)
+	i.meterIntervalMs = meterIntervalMs
+	i.meterFramesDue = meterIntervalSamples(i.sampleRate, meterIntervalMs)
+	i.mu.Unlock()
+
+	host.Log(host.LogLevelInfo, "gain_filter params set to "+formatGain(gainDB, gainLinear)+", ramp "+formatFloat(rampMs)+"ms")
+	return nil
+}
+
+// gainStateVersion guards against decoding a blob written by an incompatible
+// future layout.
+const gainStateVersion = 1
+
+func saveGainState(rep cm.Rep) ([]byte, error) {
+	return runtime.SnapshotTyped(nodes.Handles(), rep, (*gainInstance).marshalState)
+}
+
+func loadGainState(rep cm.Rep, data []byte) error {
+	return runtime.RestoreTyped(nodes.Handles(), rep, data, (*gainInstance).unmarshalState)
+}
+
+// marshalState serializes enough of the instance to resume its gain ramp
+// seamlessly across a save/reload: the last-applied gain in dB and the
+// ramp's exact in-flight state (rather than just its endpoints).
+func (i *gainInstance) marshalState() ([]byte, error) {
+	i.mu.Lock()
+	ramp := i.ramp.Snapshot()
+	gainDB := i.gainDB
+	i.mu.Unlock()
+
+	buf := new(bytes.Buffer)
+	buf.WriteByte(gainStateVersion)
+	fields := []float32{gainDB, ramp.Current, ramp.Target, ramp.Step, ramp.Coeff, float32(ramp.Shape)}
+	for _, field := range fields {
+		if err := binary.Write(buf, binary.LittleEndian, field); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func (i *gainInstance) unmarshalState(data []byte) error {
+	r := bytes.NewReader(data)
+
+	var version uint8
+	if err := binary.Read(r, binary.LittleEndian, &version); err != nil {
+		return err
+	}
+	if version != gainStateVersion {
+		return fmt.Errorf("gain_filter: unsupported state version %d", version)
+	}
+
+	var gainDB, current, target, step, coeff, shape float32
+	for _, field := range []*float32{&gainDB, &current, &target, &step, &coeff, &shape} {
+		if err := binary.Read(r, binary.LittleEndian, field); err != nil {
+			return err
+		}
+	}
+
+	i.mu.Lock()
+	i.gainDB = gainDB
+	i.ramp.Restore(runtime.RampState{
+		Current: current,
+		Target:  target,
+		Step:    step,
+		Coeff:   coeff,
+		Shape:   runtime.RampShape(shape),
+	})
+	i.mu.Unlock()
+	return nil
+}
+
+// dbToLinear converts a gain in decibels to the linear multiplier
+// ParameterRamp and ApplyGain{Int16,Float32} operate on.
+func dbToLinear(db float32) float32 {
+	return float32(math.Pow(10, float64(db)/20.0))
+}
+
+func gainSchema() string {
+	return `{
+  "type": "object",
+  "properties": {
+    "gain_db": {
+      "type": "number",
+      "default": 0.0,
+      "description": "Gain in decibels (dB)",
+      "minimum": -60.0,
+      "maximum": 20.0
+    },
+    "ramp_ms": {
+      "type": "number",
+      "default": 20.0,
+      "description": "Time in milliseconds to smoothly ramp from the previous gain to the new target (for \"exp\", the time constant of the curve)",
+      "minimum": 0.0,
+      "maximum": 5000.0
+    },
+    "ramp_shape": {
+      "type": "string",
+      "default": "lin",
+      "description": "Interpolation curve used while ramping towards the target gain",
+      "enum": ["lin", "exp"]
+    },
+    "meter_interval_ms": {
+      "type": "number",
+      "default": 50.0,
+      "description": "How often, in milliseconds, to emit a metering packet on the \"meter\" output",
+      "minimum": 1.0,
+      "maximum": 60000.0
+    }
+  }
+}`
+}
+
+func formatGain(db, linear float32) string {
+	return formatFloat(db) + "dB (linear: " + formatFloat(linear) + ")"
+}
+
+func formatFloat(v float32) string {
+	const precision = 3
+	scale := float32(math.Pow10(precision))
+	rounded := float32(math.Round(float64(v)*float64(scale))) / scale
+	return strconv.FormatFloat(float64(rounded), 'f', precision, 64)
+}
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: © 2025 StreamKit Contributors
+//
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build tinygo.wasm
+
+// Package main provides a Go implementation of a small StreamKit audio
+// filter suite (gain, pan, mute), built as a single wasm module so hosts
+// don't need to load a separate binary per filter kind. Each filter
+// registers itself with runtime.NodeRegistry in its own init(); this file
+// only wires the shared registry up to the generated bindings.
+package main
+
+import (
+	"github.com/streamkit/streamkit-codex/plugin-sdk/go/bindings/streamkit/plugin/host"
+	"github.com/streamkit/streamkit-codex/plugin-sdk/go/bindings/streamkit/plugin/node"
+	"github.com/streamkit/streamkit-codex/plugin-sdk/go/bindings/streamkit/plugin/types"
+	"github.com/streamkit/streamkit-codex/plugin-sdk/go/sdk/runtime"
+	"go.bytecodealliance.org/cm"
+)
+
+var nodes = runtime.NewNodeRegistry()
+
+func init() {
+	node.Exports.Metadata = func() cm.List[types.NodeMetadata] {
+		return cm.ToList(nodes.Metadata())
+	}
+	node.Exports.NodeInstance.Constructor = constructNode
+	node.Exports.NodeInstance.Process = nodes.Process
+	node.Exports.NodeInstance.UpdateParams = nodes.UpdateParams
+	node.Exports.NodeInstance.Cleanup = nodes.Cleanup
+	node.Exports.NodeInstance.Destructor = nodes.Destructor
+	node.Exports.NodeInstance.SaveState = nodes.SaveState
+	node.Exports.NodeInstance.LoadState = nodes.LoadState
+}
+
+// constructNode dispatches to the registered kind's own Constructor. The
+// generated bindings now pass the target kind alongside params so a single
+// module can expose a per-kind constructor table instead of one Constructor
+// per wasm binary.
+func constructNode(kind string, params cm.Option[string]) node.NodeInstance {
+	inst, ok := nodes.Construct(kind, params)
+	if !ok {
+		host.Log(host.LogLevelError, "audio_filters: unknown node kind "+kind)
+	}
+	return inst
+}
+
+func optionToPtr(opt cm.Option[string]) *string {
+	return opt.Some()
+}
+
+func clamp(val, lo, hi float32) float32 {
+	switch {
+	case val < lo:
+		return lo
+	case val > hi:
+		return hi
+	default:
+		return val
+	}
+}
+
+// TinyGo requires a main entry point for the wasip2 target even if the world
+// does not expose it, so provide a stub.
+func main() {}
@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: © 2025 StreamKit Contributors
+//
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build tinygo.wasm
+
+package main
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/streamkit/streamkit-codex/plugin-sdk/go/bindings/streamkit/plugin/host"
+	"github.com/streamkit/streamkit-codex/plugin-sdk/go/bindings/streamkit/plugin/node"
+	"github.com/streamkit/streamkit-codex/plugin-sdk/go/bindings/streamkit/plugin/types"
+	"github.com/streamkit/streamkit-codex/plugin-sdk/go/sdk/runtime"
+	"go.bytecodealliance.org/cm"
+)
+
+const muteKind = "mute_filter_go"
+
+func init() {
+	nodes.RegisterNode(muteKind, runtime.NodeDescriptor{
+		Metadata:     muteMetadata,
+		Constructor:  constructMuteInstance,
+		Process:      processMutePacket,
+		UpdateParams: updateMuteParams,
+	})
+}
+
+type muteParams struct {
+	Muted *bool `json:"muted"`
+}
+
+// muteInstance silences its input when muted, otherwise passes it through
+// unchanged. It accepts the same stereo float32 format as pan_filter_go.
+type muteInstance struct {
+	mu    sync.Mutex
+	muted bool
+}
+
+func muteMetadata() types.NodeMetadata {
+	stereoFloat32 := types.PacketTypeRawAudio(defaultAudioFormat)
+
+	inputs := []types.InputPin{
+		{
+			Name:         "in",
+			AcceptsTypes: cm.ToList([]types.PacketType{stereoFloat32}),
+		},
+	}
+
+	outputs := []types.OutputPin{
+		{
+			Name:         "out",
+			ProducesType: stereoFloat32,
+		},
+	}
+
+	return types.NodeMetadata{
+		Kind:        muteKind,
+		Inputs:      cm.ToList(inputs),
+		Outputs:     cm.ToList(outputs),
+		ParamSchema: muteSchema(),
+		Categories:  cm.ToList([]string{"audio", "filters"}),
+	}
+}
+
+func constructMuteInstance(params cm.Option[string]) node.NodeInstance {
+	inst := &muteInstance{}
+	if err := inst.applyParams(optionToPtr(params)); err != nil {
+		host.Log(host.LogLevelError, "mute_filter: failed to parse params: "+err.Error())
+	}
+	return nodes.Handles().Insert(muteKind, inst)
+}
+
+func processMutePacket(rep cm.Rep, inputPin string, packet types.Packet) runtime.Result {
+	inst, ok := runtime.GetTyped[muteInstance](nodes.Handles(), rep)
+	if !ok {
+		return runtime.Err("mute_filter: unknown instance handle")
+	}
+
+	if inputPin != "in" {
+		return runtime.Err("mute_filter: unexpected input pin")
+	}
+
+	audio := packet.Audio()
+	if audio == nil {
+		return runtime.Err("mute_filter only accepts audio packets")
+	}
+
+	inst.mu.Lock()
+	muted := inst.muted
+	inst.mu.Unlock()
+
+	if muted {
+		samples := audio.Samples.Slice()
+		for i := range samples {
+			samples[i] = 0
+		}
+		audio.Samples = cm.ToList(samples)
+	}
+
+	if sendResult := host.SendOutput("out", types.PacketAudio(*audio)); sendResult.IsErr() {
+		errVal := sendResult.Err()
+		if errVal != nil {
+			return runtime.Err(*errVal)
+		}
+		return runtime.Err("mute_filter: host send failed")
+	}
+
+	return runtime.OK()
+}
+
+func updateMuteParams(rep cm.Rep, params cm.Option[string]) runtime.Result {
+	inst, ok := runtime.GetTyped[muteInstance](nodes.Handles(), rep)
+	if !ok {
+		return runtime.Err("mute_filter: unknown instance handle")
+	}
+
+	if err := inst.applyParams(optionToPtr(params)); err != nil {
+		return runtime.Err(err.Error())
+	}
+
+	return runtime.OK()
+}
+
+func (i *muteInstance) applyParams(params *string) error {
+	muted := false
+
+	if params != nil {
+		var decoded muteParams
+		if err := json.Unmarshal([]byte(*params), &decoded); err != nil {
+			return err
+		}
+		if decoded.Muted != nil {
+			muted = *decoded.Muted
+		}
+	}
+
+	i.mu.Lock()
+	i.muted = muted
+	i.mu.Unlock()
+
+	host.Log(host.LogLevelInfo, "mute_filter params updated")
+	return nil
+}
+
+func muteSchema() string {
+	return `{
+  "type": "object",
+  "properties": {
+    "muted": {
+      "type": "boolean",
+      "default": false,
+      "description": "Whether audio passing through this node is silenced"
+    }
+  }
+}`
+}
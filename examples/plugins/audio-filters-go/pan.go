@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: © 2025 StreamKit Contributors
+//
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build tinygo.wasm
+
+package main
+
+import (
+	"encoding/json"
+	"math"
+	"sync"
+
+	"github.com/streamkit/streamkit-codex/plugin-sdk/go/bindings/streamkit/plugin/host"
+	"github.com/streamkit/streamkit-codex/plugin-sdk/go/bindings/streamkit/plugin/node"
+	"github.com/streamkit/streamkit-codex/plugin-sdk/go/bindings/streamkit/plugin/types"
+	"github.com/streamkit/streamkit-codex/plugin-sdk/go/sdk/runtime"
+	"go.bytecodealliance.org/cm"
+)
+
+const panKind = "pan_filter_go"
+
+const defaultPan = float32(0)
+
+func init() {
+	nodes.RegisterNode(panKind, runtime.NodeDescriptor{
+		Metadata:     panMetadata,
+		Constructor:  constructPanInstance,
+		Process:      processPanPacket,
+		UpdateParams: updatePanParams,
+	})
+}
+
+type panParams struct {
+	Pan *float32 `json:"pan"`
+}
+
+// panInstance applies an equal-power stereo pan. It only ever operates on
+// the fixed stereo float32 format advertised in its metadata.
+type panInstance struct {
+	mu        sync.Mutex
+	leftGain  float32
+	rightGain float32
+}
+
+func panMetadata() types.NodeMetadata {
+	stereoFloat32 := types.PacketTypeRawAudio(defaultAudioFormat)
+
+	inputs := []types.InputPin{
+		{
+			Name:         "in",
+			AcceptsTypes: cm.ToList([]types.PacketType{stereoFloat32}),
+		},
+	}
+
+	outputs := []types.OutputPin{
+		{
+			Name:         "out",
+			ProducesType: stereoFloat32,
+		},
+	}
+
+	return types.NodeMetadata{
+		Kind:        panKind,
+		Inputs:      cm.ToList(inputs),
+		Outputs:     cm.ToList(outputs),
+		ParamSchema: panSchema(),
+		Categories:  cm.ToList([]string{"audio", "filters"}),
+	}
+}
+
+func constructPanInstance(params cm.Option[string]) node.NodeInstance {
+	inst := &panInstance{}
+	if err := inst.applyParams(optionToPtr(params)); err != nil {
+		host.Log(host.LogLevelError, "pan_filter: failed to parse params: "+err.Error())
+	}
+	return nodes.Handles().Insert(panKind, inst)
+}
+
+func processPanPacket(rep cm.Rep, inputPin string, packet types.Packet) runtime.Result {
+	inst, ok := runtime.GetTyped[panInstance](nodes.Handles(), rep)
+	if !ok {
+		return runtime.Err("pan_filter: unknown instance handle")
+	}
+
+	if inputPin != "in" {
+		return runtime.Err("pan_filter: unexpected input pin")
+	}
+
+	audio := packet.Audio()
+	if audio == nil {
+		return runtime.Err("pan_filter only accepts audio packets")
+	}
+	if audio.Format.Channels != 2 {
+		return runtime.Err("pan_filter only accepts stereo audio")
+	}
+
+	inst.mu.Lock()
+	left, right := inst.leftGain, inst.rightGain
+	inst.mu.Unlock()
+
+	samples := audio.Samples.Slice()
+	for i := 0; i+1 < len(samples); i += 2 {
+		samples[i] *= left
+		samples[i+1] *= right
+	}
+	audio.Samples = cm.ToList(samples)
+
+	if sendResult := host.SendOutput("out", types.PacketAudio(*audio)); sendResult.IsErr() {
+		errVal := sendResult.Err()
+		if errVal != nil {
+			return runtime.Err(*errVal)
+		}
+		return runtime.Err("pan_filter: host send failed")
+	}
+
+	return runtime.OK()
+}
+
+func updatePanParams(rep cm.Rep, params cm.Option[string]) runtime.Result {
+	inst, ok := runtime.GetTyped[panInstance](nodes.Handles(), rep)
+	if !ok {
+		return runtime.Err("pan_filter: unknown instance handle")
+	}
+
+	if err := inst.applyParams(optionToPtr(params)); err != nil {
+		return runtime.Err(err.Error())
+	}
+
+	return runtime.OK()
+}
+
+func (i *panInstance) applyParams(params *string) error {
+	pan := defaultPan
+
+	if params != nil {
+		var decoded panParams
+		if err := json.Unmarshal([]byte(*params), &decoded); err != nil {
+			return err
+		}
+		if decoded.Pan != nil {
+			pan = clamp(*decoded.Pan, -1, 1)
+		}
+	}
+
+	// Equal-power pan law: angle sweeps from 0 (hard left) to pi/2 (hard
+	// right) as pan goes from -1 to 1.
+	angle := float64(pan+1) * math.Pi / 4
+	left := float32(math.Cos(angle))
+	right := float32(math.Sin(angle))
+
+	i.mu.Lock()
+	i.leftGain, i.rightGain = left, right
+	i.mu.Unlock()
+
+	host.Log(host.LogLevelInfo, "pan_filter params updated")
+	return nil
+}
+
+func panSchema() string {
+	return `{
+  "type": "object",
+  "properties": {
+    "pan": {
+      "type": "number",
+      "default": 0.0,
+      "description": "Stereo pan position, -1 (hard left) to 1 (hard right)",
+      "minimum": -1.0,
+      "maximum": 1.0
+    }
+  }
+}`
+}
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: © 2025 StreamKit Contributors
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package runtime
+
+import "sort"
+
+// AutomationCurve selects how ParamAutomation approaches an event's value
+// from whatever preceded it.
+type AutomationCurve int
+
+const (
+	// CurveStep jumps to the event's value instantly, at its exact sample
+	// offset.
+	CurveStep AutomationCurve = iota
+	// CurveLinear ramps to the event's value linearly, finishing exactly at
+	// its sample offset.
+	CurveLinear
+	// CurveExponential ramps towards the event's value along a one-pole
+	// curve whose time constant is the span up to its sample offset; unlike
+	// CurveLinear it has not reached the value at that offset and keeps
+	// approaching it afterwards.
+	CurveExponential
+)
+
+// ParseAutomationCurve maps the WIT-level curve string ("step"|"lin"|"exp")
+// to an AutomationCurve, defaulting to CurveStep for anything else.
+func ParseAutomationCurve(s string) AutomationCurve {
+	switch s {
+	case "lin":
+		return CurveLinear
+	case "exp":
+		return CurveExponential
+	default:
+		return CurveStep
+	}
+}
+
+// RampShape returns the ParameterRamp shape that reproduces c, for curves
+// that ramp rather than step.
+func (c AutomationCurve) RampShape() RampShape {
+	if c == CurveExponential {
+		return RampExponential
+	}
+	return RampLinear
+}
+
+// AutomationEvent is a single scheduled parameter change, timestamped in
+// absolute samples on the instance's running sample clock.
+type AutomationEvent struct {
+	At    uint64
+	Param string
+	Value float32
+	Curve AutomationCurve
+}
+
+// ParamAutomation schedules AutomationEvents per parameter name so a plugin
+// can apply them sample-accurately as audio packets advance its sample
+// clock, instead of the coarse whole-instance re-parse UpdateParams does.
+// It generalizes the ctrl-pin automation pattern (gain_db in gain_filter_go
+// today, pan/cutoff elsewhere) to any scalar target with a few lines of
+// glue: enqueue events as they arrive on the ctrl pin, then Due them out as
+// audio packets are processed.
+//
+// ParamAutomation does no locking of its own; callers already hold their
+// instance's mutex while touching it, the same convention ParameterRamp
+// follows.
+type ParamAutomation struct {
+	queues map[string][]AutomationEvent
+}
+
+// NewParamAutomation creates an empty schedule.
+func NewParamAutomation() *ParamAutomation {
+	return &ParamAutomation{queues: make(map[string][]AutomationEvent)}
+}
+
+// Enqueue schedules ev, inserting it in ascending-At order among other
+// pending events for ev.Param. Events with equal At preserve arrival order.
+func (a *ParamAutomation) Enqueue(ev AutomationEvent) {
+	queue := a.queues[ev.Param]
+	idx := sort.Search(len(queue), func(i int) bool { return queue[i].At > ev.At })
+	queue = append(queue, AutomationEvent{})
+	copy(queue[idx+1:], queue[idx:])
+	queue[idx] = ev
+	a.queues[ev.Param] = queue
+}
+
+// Due removes and returns every pending event for param with At <= upTo, in
+// ascending order.
+func (a *ParamAutomation) Due(param string, upTo uint64) []AutomationEvent {
+	queue := a.queues[param]
+	idx := 0
+	for idx < len(queue) && queue[idx].At <= upTo {
+		idx++
+	}
+	if idx == 0 {
+		return nil
+	}
+
+	due := queue[:idx:idx]
+	a.queues[param] = queue[idx:]
+	return due
+}
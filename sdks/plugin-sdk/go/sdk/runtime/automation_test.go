@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: © 2025 StreamKit Contributors
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package runtime
+
+import "testing"
+
+func TestParamAutomationEnqueueOrdersByAt(t *testing.T) {
+	a := NewParamAutomation()
+	a.Enqueue(AutomationEvent{At: 300, Param: "gain_db", Value: 3})
+	a.Enqueue(AutomationEvent{At: 100, Param: "gain_db", Value: 1})
+	a.Enqueue(AutomationEvent{At: 200, Param: "gain_db", Value: 2})
+
+	due := a.Due("gain_db", 300)
+	want := []uint64{100, 200, 300}
+	if len(due) != len(want) {
+		t.Fatalf("Due returned %d events, want %d", len(due), len(want))
+	}
+	for i, at := range want {
+		if due[i].At != at {
+			t.Errorf("due[%d].At = %d, want %d", i, due[i].At, at)
+		}
+	}
+}
+
+func TestParamAutomationEnqueueEqualAtPreservesArrivalOrder(t *testing.T) {
+	a := NewParamAutomation()
+	a.Enqueue(AutomationEvent{At: 100, Param: "gain_db", Value: 1})
+	a.Enqueue(AutomationEvent{At: 100, Param: "gain_db", Value: 2})
+	a.Enqueue(AutomationEvent{At: 100, Param: "gain_db", Value: 3})
+
+	due := a.Due("gain_db", 100)
+	want := []float32{1, 2, 3}
+	if len(due) != len(want) {
+		t.Fatalf("Due returned %d events, want %d", len(due), len(want))
+	}
+	for i, value := range want {
+		if due[i].Value != value {
+			t.Errorf("due[%d].Value = %v, want %v", i, due[i].Value, value)
+		}
+	}
+}
+
+func TestParamAutomationDueBoundary(t *testing.T) {
+	a := NewParamAutomation()
+	a.Enqueue(AutomationEvent{At: 100, Param: "gain_db", Value: 1})
+	a.Enqueue(AutomationEvent{At: 101, Param: "gain_db", Value: 2})
+
+	due := a.Due("gain_db", 100)
+	if len(due) != 1 || due[0].At != 100 {
+		t.Fatalf("Due(100) = %+v, want exactly the At=100 event", due)
+	}
+
+	due = a.Due("gain_db", 100)
+	if len(due) != 0 {
+		t.Fatalf("Due(100) after draining = %+v, want none (already removed)", due)
+	}
+
+	due = a.Due("gain_db", 101)
+	if len(due) != 1 || due[0].At != 101 {
+		t.Fatalf("Due(101) = %+v, want exactly the At=101 event", due)
+	}
+}
+
+func TestParamAutomationDueIsPerParam(t *testing.T) {
+	a := NewParamAutomation()
+	a.Enqueue(AutomationEvent{At: 0, Param: "gain_db", Value: 1})
+	a.Enqueue(AutomationEvent{At: 0, Param: "pan", Value: 2})
+
+	due := a.Due("gain_db", 0)
+	if len(due) != 1 || due[0].Param != "gain_db" {
+		t.Fatalf("Due(\"gain_db\") = %+v, want only the gain_db event", due)
+	}
+
+	due = a.Due("pan", 0)
+	if len(due) != 1 || due[0].Param != "pan" {
+		t.Fatalf("Due(\"pan\") = %+v, want only the pan event", due)
+	}
+}
+
+func TestParamAutomationDueLeavesLaterEventsQueued(t *testing.T) {
+	a := NewParamAutomation()
+	a.Enqueue(AutomationEvent{At: 50, Param: "gain_db", Value: 1})
+	a.Enqueue(AutomationEvent{At: 150, Param: "gain_db", Value: 2})
+
+	due := a.Due("gain_db", 100)
+	if len(due) != 1 || due[0].At != 50 {
+		t.Fatalf("Due(100) = %+v, want only the At=50 event", due)
+	}
+
+	due = a.Due("gain_db", 100)
+	if len(due) != 0 {
+		t.Fatalf("Due(100) again = %+v, want none yet", due)
+	}
+
+	due = a.Due("gain_db", 150)
+	if len(due) != 1 || due[0].At != 150 {
+		t.Fatalf("Due(150) = %+v, want the At=150 event", due)
+	}
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: © 2025 StreamKit Contributors
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package runtime
+
+import "github.com/streamkit/streamkit-codex/plugin-sdk/go/bindings/streamkit/plugin/types"
+
+// NegotiateAudioFormat reports whether offered exactly matches one of the
+// formats a node advertised via its AcceptsTypes/ProducesType, returning the
+// matching entry so callers can hang onto it (e.g. to avoid re-matching on
+// every subsequent packet).
+func NegotiateAudioFormat(accepted []types.AudioFormat, offered types.AudioFormat) (types.AudioFormat, bool) {
+	for _, format := range accepted {
+		if format == offered {
+			return format, true
+		}
+	}
+	return types.AudioFormat{}, false
+}
+
+// ApplyGainFloat32 multiplies each sample, normalized to [-1, 1], by the next
+// value of ramp, in place.
+func ApplyGainFloat32(samples []float32, ramp *ParameterRamp) {
+	for i := range samples {
+		samples[i] *= ramp.Next()
+	}
+}
+
+// ApplyGainInt16 multiplies each sample, normalized to [-1, 1] the same as
+// ApplyGainFloat32, by the next value of ramp, clamping as a real 16-bit PCM
+// signal would: scaled to the int16 full-scale range, clipped to
+// [-32768, 32767], then rescaled back to [-1, 1], in place. Every node
+// downstream of the gain stage (metering included) sees the same [-1, 1]
+// domain regardless of which format a packet negotiated; only the clipping
+// behavior differs between the two ApplyGain variants.
+func ApplyGainInt16(samples []float32, ramp *ParameterRamp) {
+	const (
+		minInt16  = -32768
+		maxInt16  = 32767
+		fullScale = 32768
+	)
+
+	for i := range samples {
+		v := samples[i] * fullScale * ramp.Next()
+		switch {
+		case v > maxInt16:
+			v = maxInt16
+		case v < minInt16:
+			v = minInt16
+		}
+		samples[i] = v / fullScale
+	}
+}
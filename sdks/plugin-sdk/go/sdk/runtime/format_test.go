@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: © 2025 StreamKit Contributors
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package runtime
+
+import "testing"
+
+func TestApplyGainInt16StaysNormalized(t *testing.T) {
+	samples := []float32{0.5, -0.5, 0.25, -1, 1}
+	ramp := NewParameterRamp(1)
+
+	ApplyGainInt16(samples, ramp)
+
+	for i, v := range samples {
+		if v < -1 || v > 1 {
+			t.Errorf("sample %d = %v, want within [-1, 1]", i, v)
+		}
+	}
+}
+
+func TestApplyGainInt16ClipsAtFullScale(t *testing.T) {
+	samples := []float32{1}
+	ramp := NewParameterRamp(2) // 2x gain on a full-scale sample should clip
+
+	ApplyGainInt16(samples, ramp)
+
+	// 1.0 * 32768 * 2 clipped to 32767, rescaled back to [-1, 1].
+	const want = float32(32767) / 32768
+	if samples[0] != want {
+		t.Errorf("samples[0] = %v, want %v", samples[0], want)
+	}
+}
+
+func TestApplyGainInt16MatchesFloat32BelowClip(t *testing.T) {
+	const gain = float32(0.5)
+	want := []float32{0.5, -0.5, 0.1}
+	got := append([]float32(nil), want...)
+
+	ApplyGainFloat32(want, NewParameterRamp(gain))
+	ApplyGainInt16(got, NewParameterRamp(gain))
+
+	for i := range want {
+		const tolerance = 1e-4
+		diff := want[i] - got[i]
+		if diff < 0 {
+			diff = -diff
+		}
+		if diff > tolerance {
+			t.Errorf("sample %d: float32 path = %v, int16 path = %v (diff %v > %v)", i, want[i], got[i], diff, tolerance)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: © 2025 StreamKit Contributors
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package runtime
+
+import "math"
+
+// biquad is a Direct Form II Transposed second-order IIR section, normalized
+// so a0 == 1.
+type biquad struct {
+	b0, b1, b2, a1, a2 float64
+	z1, z2             float64
+}
+
+func (f *biquad) process(x float64) float64 {
+	y := f.b0*x + f.z1
+	f.z1 = f.b1*x - f.a1*y + f.z2
+	f.z2 = f.b2*x - f.a2*y
+	return y
+}
+
+// kWeightStage1 is the first stage of the ITU-R BS.1770 K-weighting
+// pre-filter: a high-shelf approximating the acoustic effect of the head.
+func kWeightStage1(sampleRate uint32) biquad {
+	const (
+		f0 = 1681.9744509555319
+		g  = 3.99984385397
+		q  = 0.7071752369554196
+	)
+
+	fs := float64(sampleRate)
+	k := math.Tan(math.Pi * f0 / fs)
+	vh := math.Pow(10, g/20)
+	vb := math.Pow(vh, 0.4996667741545416)
+	a0 := 1 + k/q + k*k
+
+	return biquad{
+		b0: (vh + vb*k/q + k*k) / a0,
+		b1: 2 * (k*k - vh) / a0,
+		b2: (vh - vb*k/q + k*k) / a0,
+		a1: 2 * (k*k - 1) / a0,
+		a2: (1 - k/q + k*k) / a0,
+	}
+}
+
+// kWeightStage2 is the second stage of the K-weighting pre-filter: a
+// high-pass modeling the non-linear effect of the ear canal.
+func kWeightStage2(sampleRate uint32) biquad {
+	const (
+		f0 = 38.13547087613982
+		q  = 0.5003270373238773
+	)
+
+	fs := float64(sampleRate)
+	k := math.Tan(math.Pi * f0 / fs)
+	a0 := 1 + k/q + k*k
+
+	return biquad{
+		b0: 1 / a0,
+		b1: -2 / a0,
+		b2: 1 / a0,
+		a1: 2 * (k*k - 1) / a0,
+		a2: (1 - k/q + k*k) / a0,
+	}
+}
+
+// ChannelMeter tracks peak, RMS, ITU-R BS.1770 K-weighted short-term
+// loudness, and clipped-sample counts for one audio channel, so any filter
+// can embed metering without reimplementing the DSP.
+type ChannelMeter struct {
+	stage1, stage2 biquad
+
+	window     []float64
+	windowSum  float64
+	windowPos  int
+	windowFull bool
+
+	peak        float32
+	sumSquares  float64
+	sampleCount int
+	clipped     uint32
+}
+
+// NewChannelMeter creates a meter that K-weights at sampleRate and maintains
+// a short-term loudness window windowSeconds long.
+func NewChannelMeter(sampleRate uint32, windowSeconds float64) *ChannelMeter {
+	return &ChannelMeter{
+		stage1: kWeightStage1(sampleRate),
+		stage2: kWeightStage2(sampleRate),
+		window: make([]float64, int(float64(sampleRate)*windowSeconds)),
+	}
+}
+
+// Add folds one post-gain sample, normalized to [-1, 1], into the meter's
+// running peak/RMS/clip counters and its K-weighted loudness window.
+func (m *ChannelMeter) Add(sample float32) {
+	abs := sample
+	if abs < 0 {
+		abs = -abs
+	}
+	if abs > m.peak {
+		m.peak = abs
+	}
+	if sample > 1.0 || sample < -1.0 {
+		m.clipped++
+	}
+
+	m.sumSquares += float64(sample) * float64(sample)
+	m.sampleCount++
+
+	if len(m.window) == 0 {
+		return
+	}
+
+	weighted := m.stage2.process(m.stage1.process(float64(sample)))
+	sq := weighted * weighted
+
+	m.windowSum -= m.window[m.windowPos]
+	m.window[m.windowPos] = sq
+	m.windowSum += sq
+	m.windowPos++
+	if m.windowPos == len(m.window) {
+		m.windowPos = 0
+		m.windowFull = true
+	}
+}
+
+// PeakDB returns the peak over the samples folded in since the last Reset,
+// in dBFS.
+func (m *ChannelMeter) PeakDB() float32 {
+	return linearToDB(m.peak)
+}
+
+// RMSDB returns the RMS over the samples folded in since the last Reset, in
+// dBFS.
+func (m *ChannelMeter) RMSDB() float32 {
+	if m.sampleCount == 0 {
+		return linearToDB(0)
+	}
+	rms := math.Sqrt(m.sumSquares / float64(m.sampleCount))
+	return linearToDB(float32(rms))
+}
+
+// ClippedSamples returns the number of samples that exceeded +-1.0 since the
+// last Reset.
+func (m *ChannelMeter) ClippedSamples() uint32 {
+	return m.clipped
+}
+
+// LUFSShortTerm returns the current K-weighted loudness over the sliding
+// window, per ITU-R BS.1770 (LUFS = -0.691 + 10*log10(mean square)). Unlike
+// PeakDB/RMSDB/ClippedSamples it is unaffected by Reset: the window keeps
+// sliding across reporting intervals.
+func (m *ChannelMeter) LUFSShortTerm() float32 {
+	samples := m.windowPos
+	if m.windowFull {
+		samples = len(m.window)
+	}
+	if samples == 0 {
+		return float32(math.Inf(-1))
+	}
+
+	mean := m.windowSum / float64(samples)
+	if mean <= 0 {
+		return float32(math.Inf(-1))
+	}
+	return float32(-0.691 + 10*math.Log10(mean))
+}
+
+// Reset clears the peak/RMS/clip counters for the next reporting interval.
+// The loudness window is left untouched since it slides continuously.
+func (m *ChannelMeter) Reset() {
+	m.peak = 0
+	m.sumSquares = 0
+	m.sampleCount = 0
+	m.clipped = 0
+}
+
+func linearToDB(v float32) float32 {
+	if v <= 0 {
+		return float32(math.Inf(-1))
+	}
+	return float32(20 * math.Log10(float64(v)))
+}
@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: © 2025 StreamKit Contributors
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package runtime
+
+import "testing"
+
+// TestChannelMeterAfterApplyGainInt16 guards against ApplyGainInt16 and
+// ChannelMeter.Add disagreeing on sample domain: Add expects its input
+// normalized to [-1, 1] regardless of which ApplyGain variant produced it,
+// so an ordinary, non-clipping signal through the Int16 gain path must not
+// be reported as clipped.
+func TestChannelMeterAfterApplyGainInt16(t *testing.T) {
+	const sampleRate = 48_000
+	samples := make([]float32, sampleRate/10)
+	for i := range samples {
+		samples[i] = 0.5
+	}
+
+	ApplyGainInt16(samples, NewParameterRamp(1))
+
+	meter := NewChannelMeter(sampleRate, 3.0)
+	for _, s := range samples {
+		meter.Add(s)
+	}
+
+	if got := meter.ClippedSamples(); got != 0 {
+		t.Errorf("ClippedSamples() = %d, want 0 for a 0.5 DC signal at unity gain", got)
+	}
+	if peak := meter.PeakDB(); peak > 1 {
+		t.Errorf("PeakDB() = %v, want roughly -6 dBFS for a 0.5 DC signal", peak)
+	}
+}
@@ -0,0 +1,200 @@
+// SPDX-FileCopyrightText: © 2025 StreamKit Contributors
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package runtime
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/streamkit/streamkit-codex/plugin-sdk/go/bindings/streamkit/plugin/node"
+	"github.com/streamkit/streamkit-codex/plugin-sdk/go/bindings/streamkit/plugin/types"
+	"go.bytecodealliance.org/cm"
+)
+
+// Result is the WIT result<_, string> shape every NodeInstance export
+// returns.
+type Result = cm.Result[string, struct{}, string]
+
+// OK builds a successful Result.
+func OK() Result {
+	return cm.OK[Result, string, struct{}, string](struct{}{})
+}
+
+// Err builds a failed Result carrying msg.
+func Err(msg string) Result {
+	return cm.Err[Result, string, struct{}, string](msg)
+}
+
+// BytesResult is the WIT result<list<u8>, string> shape SaveState returns.
+type BytesResult = cm.Result[cm.List[uint8], cm.List[uint8], string]
+
+// OKBytes builds a successful BytesResult carrying data.
+func OKBytes(data []byte) BytesResult {
+	return cm.OK[BytesResult, cm.List[uint8], cm.List[uint8], string](cm.ToList(data))
+}
+
+// ErrBytes builds a failed BytesResult carrying msg.
+func ErrBytes(msg string) BytesResult {
+	return cm.Err[BytesResult, cm.List[uint8], cm.List[uint8], string](msg)
+}
+
+// NodeDescriptor bundles the callbacks a single node kind contributes to a
+// multi-kind wasm module. SaveState/LoadState are optional: a kind that
+// doesn't support snapshotting can leave them nil.
+type NodeDescriptor struct {
+	Metadata     func() types.NodeMetadata
+	Constructor  func(params cm.Option[string]) node.NodeInstance
+	Process      func(rep cm.Rep, inputPin string, packet types.Packet) Result
+	UpdateParams func(rep cm.Rep, params cm.Option[string]) Result
+	Cleanup      func(rep cm.Rep)
+	SaveState    func(rep cm.Rep) ([]byte, error)
+	LoadState    func(rep cm.Rep, data []byte) error
+}
+
+// NodeRegistry lets a single TinyGo binary register and serve several node
+// kinds (e.g. gain, pan, and mute filters) instead of dedicating one wasm
+// module per kind. A plugin calls RegisterNode once per kind, typically from
+// an init(), then wires the package's node.Exports directly to the
+// registry's Process/UpdateParams/Cleanup/Destructor/SaveState/LoadState
+// methods, and through small adapters to Metadata and Construct, whose
+// signatures differ from the exports'.
+//
+// Every kind shares one underlying TypedRegistry, so a resource handle
+// handed back by Construct is enough, on its own, for Process/UpdateParams/
+// Cleanup to recover which kind's callbacks to invoke.
+type NodeRegistry struct {
+	mu      sync.Mutex
+	byKind  map[string]NodeDescriptor
+	handles *TypedRegistry
+}
+
+// NewNodeRegistry constructs an empty registry.
+func NewNodeRegistry() *NodeRegistry {
+	return &NodeRegistry{
+		byKind:  make(map[string]NodeDescriptor),
+		handles: NewTypedRegistry(),
+	}
+}
+
+// RegisterNode adds a node kind to the registry under kind. The registry does
+// not compare kind with the Kind that desc.Metadata() reports.
+func (r *NodeRegistry) RegisterNode(kind string, desc NodeDescriptor) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.byKind[kind] = desc
+}
+
+// Handles returns the TypedRegistry backing every registered kind, for
+// per-kind Constructor implementations to Insert into.
+func (r *NodeRegistry) Handles() *TypedRegistry {
+	return r.handles
+}
+
+func (r *NodeRegistry) descriptor(kind string) (NodeDescriptor, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	desc, ok := r.byKind[kind]
+	return desc, ok
+}
+
+// Metadata returns the metadata for every registered kind, sorted by Kind for
+// a stable export order.
+func (r *NodeRegistry) Metadata() []types.NodeMetadata {
+	r.mu.Lock()
+	kinds := make([]string, 0, len(r.byKind))
+	for kind := range r.byKind {
+		kinds = append(kinds, kind)
+	}
+	r.mu.Unlock()
+	sort.Strings(kinds)
+
+	out := make([]types.NodeMetadata, 0, len(kinds))
+	for _, kind := range kinds {
+		desc, ok := r.descriptor(kind)
+		if !ok {
+			continue
+		}
+		out = append(out, desc.Metadata())
+	}
+	return out
+}
+
+// Construct builds a new instance of kind. It returns ok=false if kind was
+// never registered.
+func (r *NodeRegistry) Construct(kind string, params cm.Option[string]) (inst node.NodeInstance, ok bool) {
+	desc, ok := r.descriptor(kind)
+	if !ok {
+		return node.NodeInstance{}, false
+	}
+	return desc.Constructor(params), true
+}
+
+// Process routes rep to its kind's Process callback.
+func (r *NodeRegistry) Process(rep cm.Rep, inputPin string, packet types.Packet) Result {
+	desc, ok := r.descriptorFor(rep)
+	if !ok {
+		return Err("unknown node instance handle")
+	}
+	return desc.Process(rep, inputPin, packet)
+}
+
+// UpdateParams routes rep to its kind's UpdateParams callback.
+func (r *NodeRegistry) UpdateParams(rep cm.Rep, params cm.Option[string]) Result {
+	desc, ok := r.descriptorFor(rep)
+	if !ok {
+		return Err("unknown node instance handle")
+	}
+	return desc.UpdateParams(rep, params)
+}
+
+// Cleanup routes rep to its kind's Cleanup callback, if any.
+func (r *NodeRegistry) Cleanup(rep cm.Rep) {
+	if desc, ok := r.descriptorFor(rep); ok && desc.Cleanup != nil {
+		desc.Cleanup(rep)
+	}
+}
+
+// Destructor removes rep from the shared handle registry. Wire this to
+// node.Exports.NodeInstance.Destructor.
+func (r *NodeRegistry) Destructor(rep cm.Rep) {
+	r.handles.Remove(rep)
+}
+
+// SaveState routes rep to its kind's SaveState callback, if any.
+func (r *NodeRegistry) SaveState(rep cm.Rep) BytesResult {
+	desc, ok := r.descriptorFor(rep)
+	if !ok || desc.SaveState == nil {
+		return ErrBytes("unknown node instance handle or kind does not support state snapshots")
+	}
+
+	data, err := desc.SaveState(rep)
+	if err != nil {
+		return ErrBytes(err.Error())
+	}
+	return OKBytes(data)
+}
+
+// LoadState routes rep and data to its kind's LoadState callback, if any.
+func (r *NodeRegistry) LoadState(rep cm.Rep, data cm.List[uint8]) Result {
+	desc, ok := r.descriptorFor(rep)
+	if !ok || desc.LoadState == nil {
+		return Err("unknown node instance handle or kind does not support state snapshots")
+	}
+
+	if err := desc.LoadState(rep, data.Slice()); err != nil {
+		return Err(err.Error())
+	}
+	return OK()
+}
+
+func (r *NodeRegistry) descriptorFor(rep cm.Rep) (NodeDescriptor, bool) {
+	kind, ok := r.handles.Kind(rep)
+	if !ok {
+		return NodeDescriptor{}, false
+	}
+	return r.descriptor(kind)
+}
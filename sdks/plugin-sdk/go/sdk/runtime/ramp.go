@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: © 2025 StreamKit Contributors
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package runtime
+
+import "math"
+
+// RampShape selects the interpolation curve a ParameterRamp follows while
+// moving from its current value towards its target.
+type RampShape int
+
+const (
+	// RampLinear steps the value by a fixed amount per sample.
+	RampLinear RampShape = iota
+	// RampExponential approaches the target with a one-pole (asymptotic) curve.
+	RampExponential
+)
+
+// ParameterRamp smooths a scalar parameter (e.g. linear gain) from its current
+// value to a target over a configurable number of samples, so plugins can
+// avoid zipper noise/clicks from instantaneous parameter updates.
+type ParameterRamp struct {
+	current float32
+	target  float32
+	step    float32
+	coeff   float32
+	shape   RampShape
+}
+
+// NewParameterRamp creates a ramp initialized to value with no pending
+// transition.
+func NewParameterRamp(value float32) *ParameterRamp {
+	return &ParameterRamp{current: value, target: value}
+}
+
+// SetTarget begins a ramp from the ramp's current value to target over
+// rampMs milliseconds at the given sampleRate. For RampExponential, rampMs is
+// the one-pole time constant: about 63% of the distance is covered after
+// rampMs, and the ramp keeps approaching the target after that. A
+// non-positive rampMs (or sampleRate) applies the target immediately.
+func (r *ParameterRamp) SetTarget(target, rampMs float32, sampleRate uint32, shape RampShape) {
+	r.target = target
+	r.shape = shape
+
+	steps := rampMs * float32(sampleRate) / 1000.0
+	if steps <= 0 {
+		r.current = target
+		r.step = 0
+		r.coeff = 0
+		return
+	}
+
+	switch shape {
+	case RampExponential:
+		r.coeff = float32(math.Exp(-1.0 / float64(steps)))
+	default:
+		r.step = (target - r.current) / steps
+	}
+}
+
+// Next advances the ramp by one sample and returns the new current value.
+func (r *ParameterRamp) Next() float32 {
+	if r.current == r.target {
+		return r.current
+	}
+
+	switch r.shape {
+	case RampExponential:
+		next := r.target + (r.current-r.target)*r.coeff
+		// In float32 the one-pole update stops moving once the remaining
+		// distance rounds away, well before 1e-6 for larger targets; snap to
+		// the target then instead of stalling just short of it.
+		if diff := next - r.target; next == r.current || (diff < 1e-6 && diff > -1e-6) {
+			next = r.target
+		}
+		r.current = next
+	default:
+		r.current += r.step
+		if (r.step > 0 && r.current > r.target) || (r.step < 0 && r.current < r.target) {
+			r.current = r.target
+		}
+	}
+
+	return r.current
+}
+
+// Value returns the ramp's current value without advancing it.
+func (r *ParameterRamp) Value() float32 {
+	return r.current
+}
+
+// Done reports whether the ramp has reached its target.
+func (r *ParameterRamp) Done() bool {
+	return r.current == r.target
+}
+
+// RampState is a serializable snapshot of a ParameterRamp's internal state,
+// for plugins implementing SaveState/LoadState.
+type RampState struct {
+	Current float32
+	Target  float32
+	Step    float32
+	Coeff   float32
+	Shape   RampShape
+}
+
+// Snapshot captures the ramp's state so it can be restored later with
+// Restore, resuming an in-flight ramp exactly where it left off.
+func (r *ParameterRamp) Snapshot() RampState {
+	return RampState{
+		Current: r.current,
+		Target:  r.target,
+		Step:    r.step,
+		Coeff:   r.coeff,
+		Shape:   r.shape,
+	}
+}
+
+// Restore replaces the ramp's state with a snapshot previously returned by
+// Snapshot.
+func (r *ParameterRamp) Restore(s RampState) {
+	r.current = s.Current
+	r.target = s.Target
+	r.step = s.Step
+	r.coeff = s.Coeff
+	r.shape = s.Shape
+}
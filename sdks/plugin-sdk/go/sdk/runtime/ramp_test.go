@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: © 2025 StreamKit Contributors
+//
+// SPDX-License-Identifier: MPL-2.0
+
+package runtime
+
+import "testing"
+
+// TestParameterRampNoStepDiscontinuity feeds a DC input through
+// ApplyGainFloat32, flips the ramp's target mid-buffer (as applyParams does
+// on a gain_db change), and asserts the output never jumps by more than a
+// small tolerance from one sample to the next - the zipper noise the ramp
+// exists to avoid.
+func TestParameterRampNoStepDiscontinuity(t *testing.T) {
+	const (
+		sampleRate = 48_000
+		rampMs     = 20
+		tolerance  = 0.01
+	)
+
+	for _, shape := range []RampShape{RampLinear, RampExponential} {
+		ramp := NewParameterRamp(1) // unity gain, i.e. 0 dB
+		// One second, so the half after the flip is long enough for the
+		// exponential shape, which approaches its target asymptotically with
+		// a rampMs time constant, to settle on the target.
+		samples := make([]float32, sampleRate)
+		for i := range samples {
+			samples[i] = 1 // DC input
+		}
+
+		half := len(samples) / 2
+		ApplyGainFloat32(samples[:half], ramp)
+		ramp.SetTarget(10, rampMs, sampleRate, shape) // flip to +20 dB mid-buffer
+		ApplyGainFloat32(samples[half:], ramp)
+
+		for i := 1; i < len(samples); i++ {
+			if step := samples[i] - samples[i-1]; step > tolerance || step < -tolerance {
+				t.Fatalf("shape %v: step discontinuity %v between sample %d (%v) and %d (%v) exceeds tolerance %v",
+					shape, step, i-1, samples[i-1], i, samples[i], tolerance)
+			}
+		}
+
+		if got := ramp.Value(); got != 10 {
+			t.Errorf("shape %v: ramp did not reach target: got %v, want 10", shape, got)
+		}
+	}
+}
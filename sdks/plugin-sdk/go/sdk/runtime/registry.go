@@ -5,31 +5,44 @@
 package runtime
 
 import (
+	"errors"
 	"sync"
 
 	"github.com/streamkit/streamkit-codex/plugin-sdk/go/bindings/streamkit/plugin/node"
 	"go.bytecodealliance.org/cm"
 )
 
-// InstanceRegistry tracks live plugin node instances and hands out the wasm resource handle
-// expected by the generated bindings.
-type InstanceRegistry[T any] struct {
+// errUnknownHandle is returned by SnapshotTyped/RestoreTyped when rep is
+// unknown or was inserted as a different Go type than T.
+var errUnknownHandle = errors.New("unknown or mismatched instance handle")
+
+// TypedRegistry tracks live plugin node instances and hands out the wasm
+// resource handle expected by the generated bindings. Unlike a registry
+// dedicated to a single Go type, TypedRegistry stores instances of any type
+// alongside the node kind they belong to, so one registry can back every
+// kind a multi-node module (see NodeRegistry) registers.
+type TypedRegistry struct {
 	mu    sync.Mutex
 	next  uint32
-	store map[uint32]*T
+	store map[uint32]typedEntry
+}
+
+type typedEntry struct {
+	kind string
+	inst any
 }
 
-// NewInstanceRegistry constructs an empty registry.
-func NewInstanceRegistry[T any]() *InstanceRegistry[T] {
-	return &InstanceRegistry[T]{
+// NewTypedRegistry constructs an empty registry.
+func NewTypedRegistry() *TypedRegistry {
+	return &TypedRegistry{
 		next:  1,
-		store: make(map[uint32]*T),
+		store: make(map[uint32]typedEntry),
 	}
 }
 
-// Insert stores the provided instance and returns the component-model resource handle that
-// should be returned from `node.Exports.NodeInstance.Constructor`.
-func (r *InstanceRegistry[T]) Insert(inst *T) node.NodeInstance {
+// Insert stores inst under kind and returns the component-model resource
+// handle that should be returned from `node.Exports.NodeInstance.Constructor`.
+func (r *TypedRegistry) Insert(kind string, inst any) node.NodeInstance {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
@@ -39,27 +52,73 @@ func (r *InstanceRegistry[T]) Insert(inst *T) node.NodeInstance {
 		r.next = 1
 	}
 
-	r.store[handle] = inst
+	r.store[handle] = typedEntry{kind: kind, inst: inst}
 
 	rep := cm.Reinterpret[cm.Rep](handle)
 	return node.NodeInstanceResourceNew(rep)
 }
 
-// Get retrieves a previously registered instance.
-func (r *InstanceRegistry[T]) Get(rep cm.Rep) (*T, bool) {
+// Get retrieves a previously registered instance and the kind it was
+// inserted under.
+func (r *TypedRegistry) Get(rep cm.Rep) (inst any, kind string, ok bool) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
 	handle := cm.Reinterpret[uint32](rep)
-	inst, ok := r.store[handle]
-	return inst, ok
+	entry, ok := r.store[handle]
+	if !ok {
+		return nil, "", false
+	}
+	return entry.inst, entry.kind, true
 }
 
-// Remove removes an instance from the registry. This should be wired to the generated destructor.
-func (r *InstanceRegistry[T]) Remove(rep cm.Rep) {
+// Kind reports the node kind a handle was inserted under.
+func (r *TypedRegistry) Kind(rep cm.Rep) (string, bool) {
+	_, kind, ok := r.Get(rep)
+	return kind, ok
+}
+
+// Remove removes an instance from the registry. This should be wired to the
+// generated destructor.
+func (r *TypedRegistry) Remove(rep cm.Rep) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
 	handle := cm.Reinterpret[uint32](rep)
 	delete(r.store, handle)
 }
+
+// GetTyped retrieves a previously registered instance and asserts it to
+// type *T. It returns ok=false if the handle is unknown or was inserted as a
+// different Go type.
+func GetTyped[T any](r *TypedRegistry, rep cm.Rep) (*T, bool) {
+	raw, _, ok := r.Get(rep)
+	if !ok {
+		return nil, false
+	}
+	inst, ok := raw.(*T)
+	return inst, ok
+}
+
+// SnapshotTyped marshals the instance at rep, asserted to type *T, with
+// marshal. It gives plugins state portability (session save, undo, hot
+// reload of the wasm module) with a one-line NodeDescriptor.SaveState glue
+// function, without the registry needing to know how any particular kind
+// serializes itself.
+func SnapshotTyped[T any](r *TypedRegistry, rep cm.Rep, marshal func(*T) ([]byte, error)) ([]byte, error) {
+	inst, ok := GetTyped[T](r, rep)
+	if !ok {
+		return nil, errUnknownHandle
+	}
+	return marshal(inst)
+}
+
+// RestoreTyped unmarshals data into the instance at rep, asserted to type
+// *T, with unmarshal. See SnapshotTyped.
+func RestoreTyped[T any](r *TypedRegistry, rep cm.Rep, data []byte, unmarshal func(*T, []byte) error) error {
+	inst, ok := GetTyped[T](r, rep)
+	if !ok {
+		return errUnknownHandle
+	}
+	return unmarshal(inst, data)
+}